@@ -1,17 +1,21 @@
 package MT_SICS
 
 import (
-	"fmt"
-	"github.com/jacobsa/go-serial/serial"
+	"context"
 	"io"
-	"math"
 	"regexp"
-	"strconv"
 	"time"
 )
 
+// DefaultTimeout is the timeout used by the non-context variants of the commands in this package, e.g. DirectCommand.
+const DefaultTimeout = 5 * time.Second
+
 // Connect to the scale via the given port
 //
+// Deprecated: use ConnectSerial() instead, which returns a concurrency-safe Scale with automatic reconnect rather
+// than a raw connection, and supports configuring baud rate, framing and parity. Use ConnectTCP() for scales that
+// expose MT-SICS over Ethernet instead of RS-232.
+//
 // Inputs:
 //   - port: the port to connect to, e.g. COM1
 //
@@ -19,20 +23,20 @@ import (
 //   - io.ReadWriteCloser: the connection to the scale
 //   - error
 func Connect(port string) (io.ReadWriteCloser, error) {
-	options := serial.OpenOptions{
-		PortName:        port,
-		BaudRate:        9600,
-		DataBits:        8,
-		StopBits:        1,
-		MinimumReadSize: 4,
+	scale, err := ConnectSerial(SerialConfig{Port: port})
+	if err != nil {
+		return nil, err
 	}
 
-	return serial.Open(options)
+	return scale.conn, nil
 }
 
 // DirectCommand sends a command to the scale and waits for the response of the MT-balance.
 // The response is tested against the given regex, which should match the response within the timeout of 5 seconds.
 //
+// Deprecated: use (*Scale).DirectCommand() instead, which serializes concurrent callers and reuses a persistent
+// reader across calls.
+//
 // Inputs:
 //   - connection: the connection to the scale, created by Connect()
 //   - command: the command to send to the scale
@@ -42,36 +46,32 @@ func Connect(port string) (io.ReadWriteCloser, error) {
 //   - []byte: the response from the scale
 //   - error: most likely a timeout error, caused by the regex not matching to the response within 5 seconds
 func DirectCommand(connection io.ReadWriteCloser, command string, regex *regexp.Regexp) ([]byte, error) {
-	// Write
-	_, err := connection.Write([]byte(command + "\r\n"))
-	if err != nil {
-		return nil, err
-	}
-
-	// Read (until match or timeout)
-	buf := make([]byte, 128)
-	match := false
-	start := time.Now()
-	timeout := 5 * time.Second
-
-	for !match && time.Since(start) < timeout {
-		_, err = connection.Read(buf)
-		if err != nil {
-			return nil, err
-		}
-
-		match = regex.Match(buf)
-	}
-
-	if !match {
-		err = fmt.Errorf("command '%s' timed-out, want: %s, got: %s", command, regex.String(), string(buf))
-	}
+	return NewScale(connection).DirectCommand(command, regex)
+}
 
-	return buf, err
+// DirectCommandContext sends a command to the scale and waits for the response of the MT-balance, the same way
+// DirectCommand does, but takes a context.Context to control cancellation and timeout instead of the fixed 5 seconds.
+//
+// Deprecated: use (*Scale).DirectCommandContext() instead, which serializes concurrent callers and reuses a
+// persistent reader across calls.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//   - command: the command to send to the scale
+//   - regex: the regular expression to match the response from the scale
+//
+// Outputs:
+//   - []byte: the frame from the scale that matched the regex
+//   - error: ctx.Err() on cancellation/timeout, or an error from writing/reading the connection
+func DirectCommandContext(ctx context.Context, connection io.ReadWriteCloser, command string, regex *regexp.Regexp) ([]byte, error) {
+	return NewScale(connection).DirectCommandContext(ctx, command, regex)
 }
 
 // SetTarget sets a target weight and tolerance on the scale.
 //
+// Deprecated: use (*Scale).SetTarget() instead.
+//
 // Inputs:
 //   - connection: the connection to the scale, created by Connect()
 //   - target: the target weight
@@ -83,32 +83,34 @@ func DirectCommand(connection io.ReadWriteCloser, command string, regex *regexp.
 // Outputs:
 //   - error: see DirectCommand()
 func SetTarget(connection io.ReadWriteCloser, target float64, unit string, upperTolerance float64, lowerTolerance float64, relativeTolerance bool) error {
-	regex := regexp.MustCompile(`A10 A`)
-
-	targetString := "A10 0 " + strconv.FormatFloat(target, 'f', 2, 64) + " " + unit + ""
-	_, err := DirectCommand(connection, targetString, regex)
-	if err != nil {
-		return err
-	}
-
-	if relativeTolerance {
-		unit = "%"
-	}
-
-	upperToleranceString := "A10 1 " + strconv.FormatFloat(upperTolerance, 'f', 2, 64) + " " + unit + ""
-	_, err = DirectCommand(connection, upperToleranceString, regex)
-	if err != nil {
-		return err
-	}
+	return NewScale(connection).SetTarget(target, unit, upperTolerance, lowerTolerance, relativeTolerance)
+}
 
-	lowerToleranceString := "A10 2 " + strconv.FormatFloat(lowerTolerance, 'f', 2, 64) + " " + unit + ""
-	_, err = DirectCommand(connection, lowerToleranceString, regex)
-	return err
+// SetTargetContext sets a target weight and tolerance on the scale, the same way SetTarget does, but takes a
+// context.Context to control cancellation and timeout.
+//
+// Deprecated: use (*Scale).SetTargetContext() instead.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//   - target: the target weight
+//   - unit: the unit of the target weight, e.g. g
+//   - upperTolerance: the upper tolerance of the target weight
+//   - lowerTolerance: the lower tolerance of the target weight
+//   - relativeTolerance: true if the tolerance is relative (in %) or false if absolute (in unit)
+//
+// Outputs:
+//   - error: see DirectCommandContext()
+func SetTargetContext(ctx context.Context, connection io.ReadWriteCloser, target float64, unit string, upperTolerance float64, lowerTolerance float64, relativeTolerance bool) error {
+	return NewScale(connection).SetTargetContext(ctx, target, unit, upperTolerance, lowerTolerance, relativeTolerance)
 }
 
 // SetResultID sets the result ID on the scale.
 // The result ID is used to identify the measurement, e.g. the sample number or patient ID.
 //
+// Deprecated: use (*Scale).SetResultID() instead.
+//
 // Inputs:
 //   - connection: the connection to the scale, created by Connect()
 //   - label: the label of the result ID, e.g. "Sample No.:" or "Patient ID:"
@@ -117,16 +119,31 @@ func SetTarget(connection io.ReadWriteCloser, target float64, unit string, upper
 // Outputs:
 //   - error: see DirectCommand()
 func SetResultID(connection io.ReadWriteCloser, label string, value string) error {
-	msgString := "A36 1 \"" + label + "\" \"" + value + "\""
-	regex := regexp.MustCompile(`A36 A`)
+	return NewScale(connection).SetResultID(label, value)
+}
 
-	_, err := DirectCommand(connection, msgString, regex)
-	return err
+// SetResultIDContext sets the result ID on the scale, the same way SetResultID does, but takes a context.Context to
+// control cancellation and timeout.
+//
+// Deprecated: use (*Scale).SetResultIDContext() instead.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//   - label: the label of the result ID, e.g. "Sample No.:" or "Patient ID:"
+//   - value: the value of the result ID, e.g. "1234" or "John Doe"
+//
+// Outputs:
+//   - error: see DirectCommandContext()
+func SetResultIDContext(ctx context.Context, connection io.ReadWriteCloser, label string, value string) error {
+	return NewScale(connection).SetResultIDContext(ctx, label, value)
 }
 
 // SetTaskID sets the task ID on the scale.
 // The task ID is used to identify the measurement step, e.g. a duplicate number or process step.
 //
+// Deprecated: use (*Scale).SetTaskID() instead.
+//
 // Inputs:
 //   - connection: the connection to the scale, created by Connect()
 //   - label: the label of the task ID, e.g. "Duplicate No.:" or "Process step:"
@@ -135,17 +152,32 @@ func SetResultID(connection io.ReadWriteCloser, label string, value string) erro
 // Outputs:
 //   - error: see DirectCommand()
 func SetTaskID(connection io.ReadWriteCloser, label string, value string) error {
-	msgString := "A37 1 \"" + label + "\" \"" + value + "\""
-	regex := regexp.MustCompile(`A37 A`)
+	return NewScale(connection).SetTaskID(label, value)
+}
 
-	_, err := DirectCommand(connection, msgString, regex)
-	return err
+// SetTaskIDContext sets the task ID on the scale, the same way SetTaskID does, but takes a context.Context to
+// control cancellation and timeout.
+//
+// Deprecated: use (*Scale).SetTaskIDContext() instead.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//   - label: the label of the task ID, e.g. "Duplicate No.:" or "Process step:"
+//   - value: the value of the task ID, e.g. "1 of 2" or "1st weighing"
+//
+// Outputs:
+//   - error: see DirectCommandContext()
+func SetTaskIDContext(ctx context.Context, connection io.ReadWriteCloser, label string, value string) error {
+	return NewScale(connection).SetTaskIDContext(ctx, label, value)
 }
 
 // SetMessage sets a message on the display of the scale, overlaying the weight value.
 // The character limit is dependent on the scale model.
 // An empty string will clear the message.
 //
+// Deprecated: use (*Scale).SetMessage() instead.
+//
 // Inputs:
 //   - connection: the connection to the scale, created by Connect()
 //   - message: the message to display, e.g. "See PC for instructions"
@@ -153,24 +185,51 @@ func SetTaskID(connection io.ReadWriteCloser, label string, value string) error
 // Outputs:
 //   - error: see DirectCommand()
 func SetMessage(connection io.ReadWriteCloser, message string) error {
-	msgString := "D \"" + message + "\""
-	regex := regexp.MustCompile(`D A`)
+	return NewScale(connection).SetMessage(message)
+}
 
-	_, err := DirectCommand(connection, msgString, regex)
-	return err
+// SetMessageContext sets a message on the display of the scale, the same way SetMessage does, but takes a
+// context.Context to control cancellation and timeout.
+//
+// Deprecated: use (*Scale).SetMessageContext() instead.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//   - message: the message to display, e.g. "See PC for instructions"
+//
+// Outputs:
+//   - error: see DirectCommandContext()
+func SetMessageContext(ctx context.Context, connection io.ReadWriteCloser, message string) error {
+	return NewScale(connection).SetMessageContext(ctx, message)
 }
 
 // ShowWeight clears the message on the display of the scale, showing the weight value.
 //
+// Deprecated: use (*Scale).ShowWeight() instead.
+//
 // Inputs:
 //   - connection: the connection to the scale, created by Connect()
 //
 // Outputs:
 //   - error: see DirectCommand()
 func ShowWeight(connection io.ReadWriteCloser) error {
-	regex := regexp.MustCompile(`DW A`)
-	_, err := DirectCommand(connection, "DW", regex)
-	return err
+	return NewScale(connection).ShowWeight()
+}
+
+// ShowWeightContext clears the message on the display of the scale, the same way ShowWeight does, but takes a
+// context.Context to control cancellation and timeout.
+//
+// Deprecated: use (*Scale).ShowWeightContext() instead.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//
+// Outputs:
+//   - error: see DirectCommandContext()
+func ShowWeightContext(ctx context.Context, connection io.ReadWriteCloser) error {
+	return NewScale(connection).ShowWeightContext(ctx)
 }
 
 // GetUnit retrieves the unit currently used by the scale.
@@ -179,6 +238,8 @@ func ShowWeight(connection io.ReadWriteCloser) error {
 //   - 1: Display unit, used on the scale display
 //   - 2: Info unit, used on the info field on the scale's display
 //
+// Deprecated: use (*Scale).GetUnit() instead.
+//
 // Inputs:
 //   - connection: the connection to the scale, created by Connect()
 //   - channel: the channel to retrieve the unit from
@@ -187,14 +248,24 @@ func ShowWeight(connection io.ReadWriteCloser) error {
 //   - unit: the unit used on the specified channel, e.g. "g"
 //   - error: see DirectCommand()
 func GetUnit(connection io.ReadWriteCloser, channel int) (string, error) {
-	regex := regexp.MustCompile(`M21 A [0-9] ([a-zA-Z]+)`)
-	buf, err := DirectCommand(connection, "M21 "+strconv.Itoa(channel), regex)
-	if err != nil {
-		return "", err
-	}
+	return NewScale(connection).GetUnit(channel)
+}
 
-	result := regex.FindStringSubmatch(string(buf))
-	return result[1], nil
+// GetUnitContext retrieves the unit currently used by the scale, the same way GetUnit does, but takes a
+// context.Context to control cancellation and timeout.
+//
+// Deprecated: use (*Scale).GetUnitContext() instead.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//   - channel: the channel to retrieve the unit from
+//
+// Outputs:
+//   - unit: the unit used on the specified channel, e.g. "g"
+//   - error: see DirectCommandContext()
+func GetUnitContext(ctx context.Context, connection io.ReadWriteCloser, channel int) (string, error) {
+	return NewScale(connection).GetUnitContext(ctx, channel)
 }
 
 // SetUnit sets the unit used by the scale.
@@ -203,43 +274,88 @@ func GetUnit(connection io.ReadWriteCloser, channel int) (string, error) {
 //   - 1: Display unit, used on the scale display
 //   - 2: Info unit, used on the info field on the scale's display
 //
+// Deprecated: use (*Scale).SetUnit() instead.
+//
 // Inputs:
 //   - connection: the connection to the scale, created by Connect()
 //   - unit: the unit to use on the specified channel, e.g. "g". Make sure to use proper capitalization.
 func SetUnit(connection io.ReadWriteCloser, unit string, channel int) error {
-	regex := regexp.MustCompile(`M21 A`)
-	_, err := DirectCommand(connection, "M21 "+strconv.Itoa(channel)+" "+unit, regex)
-	return err
+	return NewScale(connection).SetUnit(unit, channel)
+}
+
+// SetUnitContext sets the unit used by the scale, the same way SetUnit does, but takes a context.Context to
+// control cancellation and timeout.
+//
+// Deprecated: use (*Scale).SetUnitContext() instead.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//   - unit: the unit to use on the specified channel, e.g. "g". Make sure to use proper capitalization.
+func SetUnitContext(ctx context.Context, connection io.ReadWriteCloser, unit string, channel int) error {
+	return NewScale(connection).SetUnitContext(ctx, unit, channel)
 }
 
 // PowerOn turns the scale on from stand-by mode.
 //
+// Deprecated: use (*Scale).PowerOn() instead.
+//
 // Inputs:
 //   - connection: the connection to the scale, created by Connect()
 //
 // Outputs:
 //   - error: see DirectCommand()
 func PowerOn(connection io.ReadWriteCloser) error {
-	regex := regexp.MustCompile(`PWR [AL]`) // PWR L will be returned if the scale is already on
-	_, err := DirectCommand(connection, "PWR 1", regex)
-	return err
+	return NewScale(connection).PowerOn()
+}
+
+// PowerOnContext turns the scale on from stand-by mode, the same way PowerOn does, but takes a context.Context to
+// control cancellation and timeout.
+//
+// Deprecated: use (*Scale).PowerOnContext() instead.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//
+// Outputs:
+//   - error: see DirectCommandContext()
+func PowerOnContext(ctx context.Context, connection io.ReadWriteCloser) error {
+	return NewScale(connection).PowerOnContext(ctx)
 }
 
 // PowerOff turns the scale into stand-by mode.
 //
+// Deprecated: use (*Scale).PowerOff() instead.
+//
 // Inputs:
 //   - connection: the connection to the scale, created by Connect()
 //
 // Outputs:
 //   - error: see DirectCommand()
 func PowerOff(connection io.ReadWriteCloser) error {
-	regex := regexp.MustCompile(`PWR [AL]`) // PWR L will be returned if the scale is already off
-	_, err := DirectCommand(connection, "PWR 0", regex)
-	return err
+	return NewScale(connection).PowerOff()
+}
+
+// PowerOffContext turns the scale into stand-by mode, the same way PowerOff does, but takes a context.Context to
+// control cancellation and timeout.
+//
+// Deprecated: use (*Scale).PowerOffContext() instead.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//
+// Outputs:
+//   - error: see DirectCommandContext()
+func PowerOffContext(ctx context.Context, connection io.ReadWriteCloser) error {
+	return NewScale(connection).PowerOffContext(ctx)
 }
 
 // Weight retrieves the weight from the scale.
 //
+// Deprecated: use (*Scale).Weight() instead.
+//
 // Inputs:
 //   - connection: the connection to the scale, created by Connect()
 //
@@ -247,24 +363,30 @@ func PowerOff(connection io.ReadWriteCloser) error {
 //   - measurement: the weight and unit of the measurement
 //   - error: see DirectCommand()
 func Weight(connection io.ReadWriteCloser) (Measurement, error) {
-	regex := regexp.MustCompile(`S S +(-?[0-9]+\.[0-9]+) ([a-zA-Z]+)`)
-	buf, err := DirectCommand(connection, "S", regex)
-	if err != nil {
-		return Measurement{}, err
-	}
-
-	result := regex.FindStringSubmatch(string(buf))
-	weightValue, err := strconv.ParseFloat(result[1], 64)
-	if err != nil {
-		return Measurement{}, err
-	}
+	return NewScale(connection).Weight()
+}
 
-	return Measurement{weightValue, result[2]}, nil
+// WeightContext retrieves the weight from the scale, the same way Weight does, but takes a context.Context to
+// control cancellation and timeout.
+//
+// Deprecated: use (*Scale).WeightContext() instead.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//
+// Outputs:
+//   - measurement: the weight and unit of the measurement
+//   - error: see DirectCommandContext()
+func WeightContext(ctx context.Context, connection io.ReadWriteCloser) (Measurement, error) {
+	return NewScale(connection).WeightContext(ctx)
 }
 
 // WeightOnKey retrieves the weight from the scale when the transfer-key is pressed.
 // The function will wait until the key has been pressed numMeasurements times, or until timeout is reached.
 //
+// Deprecated: use (*Scale).WeightOnKey() instead.
+//
 // Inputs:
 //
 //   - connection: the connection to the scale, created by Connect()
@@ -279,55 +401,31 @@ func Weight(connection io.ReadWriteCloser) (Measurement, error) {
 //   - []Measurement: the weights and units of the measurements
 //   - error: see DirectCommand()
 func WeightOnKey(connection io.ReadWriteCloser, numMeasurements int, timeout time.Duration) ([]Measurement, error) {
-	if timeout == 0 && numMeasurements == 0 {
-		return []Measurement{}, fmt.Errorf("timeout and numMeasurements cannot both be infinite (0)")
-	}
-	if timeout == 0 {
-		timeout = 1<<63 - 1 // MaxInt64 = 292 years
-	}
-	if numMeasurements == 0 {
-		numMeasurements = int(math.Inf(1))
-	}
-
-	initRegex := regexp.MustCompile(`ST A`)
-	_, err := DirectCommand(connection, "ST 1", initRegex)
-	if err != nil {
-		return []Measurement{}, err
-	}
-
-	weightRegex := regexp.MustCompile(`ST +(-?[0-9]+\.[0-9]+) ([a-zA-Z]+)`)
-	// Read (until match or timeout)
-	buf := make([]byte, 128)
-	start := time.Now()
-	i := 0
-	var weightList []Measurement
-	for i < numMeasurements && time.Since(start) < timeout {
-		_, err = connection.Read(buf)
-		if err != nil {
-			return []Measurement{}, err
-		}
-
-		if weightRegex.Match(buf) {
-			result := weightRegex.FindStringSubmatch(string(buf))
-			weightValue, err := strconv.ParseFloat(result[1], 64)
-			if err != nil {
-				return []Measurement{}, err
-			}
-
-			weightList = append(weightList, Measurement{weightValue, result[2]})
-			i++
-		}
-	}
-	defer func() {
-		stopRegex := regexp.MustCompile(`ST [AL]`) // ST L will be returned if the reading is already stopped by user interrupt
-		_, _ = DirectCommand(connection, "ST 0", stopRegex)
-	}()
+	return NewScale(connection).WeightOnKey(numMeasurements, timeout)
+}
 
-	return weightList, nil
+// WeightOnKeyContext retrieves the weight from the scale when the transfer-key is pressed, the same way
+// WeightOnKey does, but takes a context.Context to control cancellation and timeout instead of the timeout
+// parameter.
+//
+// Deprecated: use (*Scale).WeightOnKeyContext() instead.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//   - numMeasurements: the number of measurements to take, or 0 for infinite. ctx must be cancelable if 0 is used.
+//
+// Outputs:
+//   - []Measurement: the weights and units of the measurements
+//   - error: see DirectCommandContext()
+func WeightOnKeyContext(ctx context.Context, connection io.ReadWriteCloser, numMeasurements int) ([]Measurement, error) {
+	return NewScale(connection).WeightOnKeyContext(ctx, numMeasurements)
 }
 
 // Tare sets the current weight as the tare weight.
 //
+// Deprecated: use (*Scale).Tare() instead.
+//
 // Inputs:
 //   - connection: the connection to the scale, created by Connect()
 //
@@ -335,21 +433,29 @@ func WeightOnKey(connection io.ReadWriteCloser, numMeasurements int, timeout tim
 //   - []Measurement: the weight and unit of the measurement
 //   - error: see DirectCommand()
 func Tare(connection io.ReadWriteCloser) (Measurement, error) {
-	regex := regexp.MustCompile(`T S +(-?[0-9]+\.[0-9]+) ([a-zA-Z]+)`)
-	buf, err := DirectCommand(connection, "T", regex)
-	if err != nil {
-		return Measurement{}, err
-	}
-	result := regex.FindStringSubmatch(string(buf))
-	weightValue, err := strconv.ParseFloat(result[1], 64)
-	if err != nil {
-		return Measurement{}, err
-	}
-	return Measurement{weightValue, result[2]}, nil
+	return NewScale(connection).Tare()
+}
+
+// TareContext sets the current weight as the tare weight, the same way Tare does, but takes a context.Context to
+// control cancellation and timeout.
+//
+// Deprecated: use (*Scale).TareContext() instead.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//
+// Outputs:
+//   - Measurement: the weight and unit of the measurement
+//   - error: see DirectCommandContext()
+func TareContext(ctx context.Context, connection io.ReadWriteCloser) (Measurement, error) {
+	return NewScale(connection).TareContext(ctx)
 }
 
 // GetDoorStatus retrieves the status of the Draft shield doors.
 //
+// Deprecated: use (*Scale).GetDoorStatus() instead.
+//
 // Inputs:
 //   - connection: the connection to the scale, created by Connect()
 //
@@ -370,70 +476,143 @@ func Tare(connection io.ReadWriteCloser) (Measurement, error) {
 //	8: Error
 //	9: Intermediate
 func GetDoorStatus(connection io.ReadWriteCloser) (string, error) {
-	regex := regexp.MustCompile(`WS`)
-	buf, err := DirectCommand(connection, "WS ([0-9])", regex)
-	if err != nil {
-		return "", err
-	}
+	return NewScale(connection).GetDoorStatus()
+}
 
-	result := regex.FindStringSubmatch(string(buf))
-	return result[1], nil
+// GetDoorStatusContext retrieves the status of the Draft shield doors, the same way GetDoorStatus does, but takes a
+// context.Context to control cancellation and timeout. See GetDoorStatus() for the status table.
+//
+// Deprecated: use (*Scale).GetDoorStatusContext() instead.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//
+// Outputs:
+//   - string: the status of the doors, according to the status table
+//   - error: see DirectCommandContext()
+func GetDoorStatusContext(ctx context.Context, connection io.ReadWriteCloser) (string, error) {
+	return NewScale(connection).GetDoorStatusContext(ctx)
 }
 
 // CloseAllDoors closes all draft shield doors.
 //
+// Deprecated: use (*Scale).CloseAllDoors() instead.
+//
 // Inputs:
 //   - connection: the connection to the scale, created by Connect()
 //
 // Outputs:
 //   - error: see DirectCommand()
 func CloseAllDoors(connection io.ReadWriteCloser) error {
-	regex := regexp.MustCompile(`WS [AL]`) // WS L will be returned if the doors are already closed
-	_, err := DirectCommand(connection, "WS 0", regex)
-	return err
+	return NewScale(connection).CloseAllDoors()
+}
+
+// CloseAllDoorsContext closes all draft shield doors, the same way CloseAllDoors does, but takes a context.Context
+// to control cancellation and timeout.
+//
+// Deprecated: use (*Scale).CloseAllDoorsContext() instead.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//
+// Outputs:
+//   - error: see DirectCommandContext()
+func CloseAllDoorsContext(ctx context.Context, connection io.ReadWriteCloser) error {
+	return NewScale(connection).CloseAllDoorsContext(ctx)
 }
 
 // OpenRightDoor opens the right draft shield door.
 //
+// Deprecated: use (*Scale).OpenRightDoor() instead.
+//
 // Inputs:
 //   - connection: the connection to the scale, created by Connect()
 //
 // Outputs:
 //   - error: see DirectCommand()
 func OpenRightDoor(connection io.ReadWriteCloser) error {
-	regex := regexp.MustCompile(`WS [AL]`) // WS L will be returned if the right door is already open
-	_, err := DirectCommand(connection, "WS 1", regex)
-	return err
+	return NewScale(connection).OpenRightDoor()
+}
+
+// OpenRightDoorContext opens the right draft shield door, the same way OpenRightDoor does, but takes a
+// context.Context to control cancellation and timeout.
+//
+// Deprecated: use (*Scale).OpenRightDoorContext() instead.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//
+// Outputs:
+//   - error: see DirectCommandContext()
+func OpenRightDoorContext(ctx context.Context, connection io.ReadWriteCloser) error {
+	return NewScale(connection).OpenRightDoorContext(ctx)
 }
 
 // OpenLeftDoor opens the left draft shield door.
 //
+// Deprecated: use (*Scale).OpenLeftDoor() instead.
+//
 // Inputs:
 //   - connection: the connection to the scale, created by Connect()
 //
 // Outputs:
 //   - error: see DirectCommand()
 func OpenLeftDoor(connection io.ReadWriteCloser) error {
-	regex := regexp.MustCompile(`WS [AL]`) // WS L will be returned if the left door is already open
-	_, err := DirectCommand(connection, "WS 2", regex)
-	return err
+	return NewScale(connection).OpenLeftDoor()
+}
+
+// OpenLeftDoorContext opens the left draft shield door, the same way OpenLeftDoor does, but takes a
+// context.Context to control cancellation and timeout.
+//
+// Deprecated: use (*Scale).OpenLeftDoorContext() instead.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//
+// Outputs:
+//   - error: see DirectCommandContext()
+func OpenLeftDoorContext(ctx context.Context, connection io.ReadWriteCloser) error {
+	return NewScale(connection).OpenLeftDoorContext(ctx)
 }
 
 // Zero sets the current weight as the zero weight.
 //
+// Deprecated: use (*Scale).Zero() instead.
+//
 // Inputs:
 //   - connection: the connection to the scale, created by Connect()
 //
 // Outputs:
 //   - error: see DirectCommand()
 func Zero(connection io.ReadWriteCloser) error {
-	regex := regexp.MustCompile(`Z A`)
-	_, err := DirectCommand(connection, "Z", regex)
-	return err
+	return NewScale(connection).Zero()
+}
+
+// ZeroContext sets the current weight as the zero weight, the same way Zero does, but takes a context.Context to
+// control cancellation and timeout.
+//
+// Deprecated: use (*Scale).ZeroContext() instead.
+//
+// Inputs:
+//   - ctx: controls cancellation and timeout of the command. A typical caller uses context.WithTimeout()
+//   - connection: the connection to the scale, created by Connect()
+//
+// Outputs:
+//   - error: see DirectCommandContext()
+func ZeroContext(ctx context.Context, connection io.ReadWriteCloser) error {
+	return NewScale(connection).ZeroContext(ctx)
 }
 
 // Measurement represents a measurement on the scale.
 type Measurement struct {
 	Weight float64
 	Unit   string
+	// Stable reports whether the scale had settled on Weight, as opposed to a dynamic, still-changing reading.
+	// Commands that only ever return the scale's final, settled value (Weight, Tare, WeightOnKey) always set this
+	// to true; it is mainly meaningful for Scale.StreamWeight, which can also report dynamic readings.
+	Stable bool
 }
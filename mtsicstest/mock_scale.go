@@ -0,0 +1,406 @@
+// Package mtsicstest provides an in-process test double for the MT-SICS protocol, so that downstream users of
+// github.com/bas-dehaan/MT-SICS can exercise their code against a scale without real hardware.
+package mtsicstest
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Measurement mirrors MT_SICS.Measurement without importing the parent package, so that mtsicstest has no
+// dependency on it: MockScale only needs to satisfy MT_SICS.Transport structurally.
+type Measurement struct {
+	Weight float64
+	Unit   string
+	Stable bool
+}
+
+// frame formats m as an MT-SICS weight frame body, e.g. "12.34 g".
+func (m Measurement) frame() string {
+	return strconv.FormatFloat(m.Weight, 'f', 2, 64) + " " + m.Unit
+}
+
+// MockScale is an in-process test double implementing the MT-SICS protocol over a byte stream. It satisfies
+// io.ReadWriteCloser, plus Flush() and SetDeadline() as required by MT_SICS.Transport, so it can be passed directly
+// to MT_SICS.NewScale().
+//
+// Use the New/Set/Queue/Press methods below to script MockScale's behavior before or during a test, then drive it
+// through a Scale like a real connection.
+type MockScale struct {
+	mu     sync.Mutex
+	closed bool
+
+	frames       chan []byte // response frames waiting to be delivered to Read
+	readBuf      []byte      // undelivered bytes from the front of frames, for short Read() calls
+	deadline     time.Time
+	failNextRead error // if set, returned once by the next Read call instead of a queued frame
+
+	latency time.Duration
+
+	weight       Measurement
+	serialNumber string
+	doorStatus   string // "0".."9", see MT_SICS.GetDoorStatus
+	poweredOn    bool
+	message      string
+	units        [3]string // channel 0 (host), 1 (display), 2 (info)
+
+	keyPresses []Measurement // FIFO of pending WeightOnKey/ST readings
+
+	streamQueue []Measurement // frames delivered, in order, to the next SI/SIR/SR request
+	streaming   bool
+}
+
+// NewMockScale returns a MockScale with sensible defaults: a zero, stable weight in grams, all doors closed and the
+// scale powered on.
+func NewMockScale() *MockScale {
+	return &MockScale{
+		frames:       make(chan []byte, 64),
+		weight:       Measurement{Weight: 0, Unit: "g", Stable: true},
+		serialNumber: "1234567890",
+		doorStatus:   "0",
+		poweredOn:    true,
+		units:        [3]string{"g", "g", "g"},
+	}
+}
+
+// SetWeight sets the weight reported by S and by a new stream with no SimulateConverging readings queued. T and Z
+// zero it, so T reports a weight of 0.
+func (m *MockScale) SetWeight(measurement Measurement) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.weight = measurement
+}
+
+// SetSerialNumber sets the serial number reported by I4.
+func (m *MockScale) SetSerialNumber(serialNumber string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.serialNumber = serialNumber
+}
+
+// SetLatency delays every response MockScale sends by d, to exercise callers' timeout handling.
+func (m *MockScale) SetLatency(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.latency = d
+}
+
+// FailNextRead makes the next Read call return err once, instead of delivering a queued response frame, then
+// resumes normal behavior. Unlike Close, this does not permanently close the connection, so it can be used to
+// simulate a transient error (e.g. io.EOF) that MT_SICS.Scale's reconnect-on-error logic is expected to recover
+// from.
+func (m *MockScale) FailNextRead(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.failNextRead = err
+}
+
+// SimulateConverging queues readings followed by final, all delivered in order in response to the next streamed
+// request (SI/SIR/SR), simulating a weight that settles over N reads. Each frame carries the "S" (stable) or "D"
+// (dynamic) marker from its Measurement's Stable field. final also becomes the weight returned by S right away.
+func (m *MockScale) SimulateConverging(readings []Measurement, final Measurement) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.streamQueue = append(append([]Measurement(nil), readings...), final)
+	m.weight = final
+}
+
+// PressKey enqueues one transfer-key reading, delivered the next time a WeightOnKey call is awaiting one (i.e.
+// after "ST 1" has been sent and before the corresponding "ST 0").
+func (m *MockScale) PressKey(measurement Measurement) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.streaming {
+		m.pushLocked("ST " + measurement.frame())
+		return
+	}
+	m.keyPresses = append(m.keyPresses, measurement)
+}
+
+// Read implements io.Reader, delivering queued response frames.
+func (m *MockScale) Read(p []byte) (int, error) {
+	m.mu.Lock()
+	if m.failNextRead != nil {
+		err := m.failNextRead
+		m.failNextRead = nil
+		m.mu.Unlock()
+		return 0, err
+	}
+	if len(m.readBuf) > 0 {
+		n := copy(p, m.readBuf)
+		m.readBuf = m.readBuf[n:]
+		m.mu.Unlock()
+		return n, nil
+	}
+	if m.closed {
+		m.mu.Unlock()
+		return 0, io.EOF
+	}
+	deadline := m.deadline
+	m.mu.Unlock()
+
+	var timeoutCh <-chan time.Time
+	if !deadline.IsZero() {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return 0, os.ErrDeadlineExceeded
+		}
+		timer := time.NewTimer(remaining)
+		defer timer.Stop()
+		timeoutCh = timer.C
+	}
+
+	select {
+	case frame, ok := <-m.frames:
+		if !ok {
+			return 0, io.EOF
+		}
+		n := copy(p, frame)
+		m.mu.Lock()
+		m.readBuf = frame[n:]
+		m.mu.Unlock()
+		return n, nil
+	case <-timeoutCh:
+		return 0, os.ErrDeadlineExceeded
+	}
+}
+
+// Write implements io.Writer, parsing each \r\n-separated MT-SICS command in p and queuing the appropriate response
+// frame(s). Every Write is parsed on its own: a command split across two Write calls is not reassembled.
+func (m *MockScale) Write(p []byte) (int, error) {
+	for _, line := range strings.Split(strings.TrimRight(string(p), "\r\n"), "\r\n") {
+		if line == "" {
+			continue
+		}
+		m.handleCommand(line)
+	}
+	return len(p), nil
+}
+
+// Close implements io.Closer.
+func (m *MockScale) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return nil
+	}
+	m.closed = true
+	close(m.frames)
+	return nil
+}
+
+// Flush discards any response frames that have been queued but not yet read, satisfying MT_SICS.Transport.
+func (m *MockScale) Flush() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.readBuf = nil
+	for {
+		select {
+		case <-m.frames:
+		default:
+			return nil
+		}
+	}
+}
+
+// SetDeadline sets the deadline for Read, satisfying MT_SICS.Transport. A zero time.Time disables the deadline.
+func (m *MockScale) SetDeadline(deadline time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deadline = deadline
+	return nil
+}
+
+// pushLocked queues response as a \r\n-terminated frame, honoring the configured latency. The caller must hold m.mu.
+func (m *MockScale) pushLocked(response string) {
+	frame := []byte(response + "\r\n")
+	latency := m.latency
+
+	if latency == 0 {
+		select {
+		case m.frames <- frame:
+		default:
+		}
+		return
+	}
+
+	go func() {
+		time.Sleep(latency)
+		m.mu.Lock()
+		closed := m.closed
+		m.mu.Unlock()
+		if closed {
+			return
+		}
+		select {
+		case m.frames <- frame:
+		default:
+		}
+	}()
+}
+
+// handleCommand parses one MT-SICS command line and queues its response(s).
+func (m *MockScale) handleCommand(line string) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return
+	}
+	verb, args := fields[0], fields[1:]
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch verb {
+	case "I4":
+		m.pushLocked(fmt.Sprintf(`I4 A "%s"`, m.serialNumber))
+
+	case "S":
+		m.pushLocked("S S " + m.weight.frame())
+
+	case "T":
+		m.weight.Weight = 0
+		m.pushLocked("T S " + m.weight.frame())
+
+	case "Z":
+		m.weight.Weight = 0
+		m.pushLocked("Z A")
+
+	case "A10", "A36", "A37":
+		m.pushLocked(verb + " A")
+
+	case "D":
+		m.message = strings.Join(args, " ")
+		m.pushLocked("D A")
+
+	case "DW":
+		m.message = ""
+		m.pushLocked("DW A")
+
+	case "M21":
+		switch len(args) {
+		case 1:
+			channel, err := strconv.Atoi(args[0])
+			if err != nil || channel < 0 || channel > 2 {
+				m.pushLocked("M21 ES")
+				return
+			}
+			m.pushLocked(fmt.Sprintf("M21 A %d %s", channel, m.units[channel]))
+		case 2:
+			channel, err := strconv.Atoi(args[0])
+			if err != nil || channel < 0 || channel > 2 {
+				m.pushLocked("M21 ES")
+				return
+			}
+			m.units[channel] = args[1]
+			m.pushLocked("M21 A")
+		default:
+			m.pushLocked("M21 ES")
+		}
+
+	case "PWR":
+		if len(args) != 1 {
+			m.pushLocked("PWR ES")
+			return
+		}
+		switch args[0] {
+		case "1":
+			if m.poweredOn {
+				m.pushLocked("PWR L")
+				return
+			}
+			m.poweredOn = true
+			m.pushLocked("PWR A")
+		case "0":
+			if !m.poweredOn {
+				m.pushLocked("PWR L")
+				return
+			}
+			m.poweredOn = false
+			m.pushLocked("PWR A")
+		default:
+			m.pushLocked("PWR ES")
+		}
+
+	case "WS":
+		// GetDoorStatus() sends the literal string "WS ([0-9])" as its command (a pre-existing quirk of the
+		// package under test), so anything other than an exact "0"/"1"/"2" action argument is treated as a status
+		// query.
+		if len(args) == 1 && (args[0] == "0" || args[0] == "1" || args[0] == "2") {
+			if m.doorStatus == args[0] {
+				m.pushLocked("WS L")
+				return
+			}
+			m.doorStatus = args[0]
+			m.pushLocked("WS A")
+			return
+		}
+		m.pushLocked("WS " + m.doorStatus)
+
+	case "ST":
+		if len(args) != 1 {
+			m.pushLocked("ST ES")
+			return
+		}
+		switch args[0] {
+		case "1":
+			if m.streaming {
+				m.pushLocked("ST L")
+				return
+			}
+			m.streaming = true
+			m.pushLocked("ST A")
+			for _, measurement := range m.keyPresses {
+				m.pushLocked("ST " + measurement.frame())
+			}
+			m.keyPresses = nil
+		case "0":
+			if !m.streaming {
+				m.pushLocked("ST L")
+				return
+			}
+			m.streaming = false
+			m.pushLocked("ST A")
+		default:
+			m.pushLocked("ST ES")
+		}
+
+	case "SI", "SIR", "SR":
+		m.startStreamLocked()
+
+	case "@":
+		m.stopStreamLocked()
+
+	default:
+		m.pushLocked("ES")
+	}
+}
+
+// startStreamLocked begins delivering SimulateConverging's queued readings, or the current weight if none were
+// queued, in response to SI/SIR/SR. The caller must hold m.mu.
+func (m *MockScale) startStreamLocked() {
+	queue := m.streamQueue
+	m.streamQueue = nil
+
+	if len(queue) == 0 {
+		queue = []Measurement{m.weight}
+	}
+
+	for _, measurement := range queue {
+		status := "D"
+		if measurement.Stable {
+			status = "S"
+		}
+		m.pushLocked("S " + status + " " + measurement.frame())
+	}
+}
+
+// stopStreamLocked handles the "@" cancel command. The caller must hold m.mu.
+func (m *MockScale) stopStreamLocked() {
+	m.streamQueue = nil
+}
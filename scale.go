@@ -0,0 +1,638 @@
+package MT_SICS
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Scale is a concurrency-safe MT-SICS client wrapping a single connection to a balance.
+//
+// Unlike the free functions in this package, which take a raw io.ReadWriteCloser and perform unsynchronized
+// Write/Read pairs, Scale serializes every request/response transaction behind a sync.Mutex, so it is safe to share
+// a single Scale between goroutines. Scale also keeps a persistent, line-buffered reader across calls, so bytes
+// read past a matched frame are never discarded.
+//
+// Create a Scale with NewScale() (wrapping an existing connection), or ConnectSerial()/ConnectTCP() (dialing a
+// Transport, with automatic reconnect on io.EOF). The command layer below is transport-agnostic: NewScale() accepts
+// any io.ReadWriteCloser, and additionally uses Transport.SetDeadline() as a best-effort optimization to bound reads
+// when the connection implements Transport; see readFrame for why ctx.Done() is still raced separately.
+type Scale struct {
+	mu      sync.Mutex
+	conn    io.ReadWriteCloser
+	reader  *bufio.Reader
+	timeout time.Duration
+	dial    func() (io.ReadWriteCloser, error)
+
+	// pending holds the result channel of a still-in-flight reader.ReadString goroutine that a prior readFrame call
+	// abandoned on ctx cancellation, so the next readFrame call joins it instead of starting a second goroutine
+	// reading the same bufio.Reader concurrently. nil when no read is in flight.
+	pending chan readResult
+}
+
+// readResult is the outcome of a single reader.ReadString('\n') call, delivered across goroutines by readFrame.
+type readResult struct {
+	line []byte
+	err  error
+}
+
+// NewScale wraps an existing connection, e.g. one returned by OpenSerial() or OpenTCP(), in a Scale.
+//
+// Inputs:
+//   - connection: the connection to the scale
+//
+// Outputs:
+//   - *Scale
+func NewScale(connection io.ReadWriteCloser) *Scale {
+	return &Scale{
+		conn:    connection,
+		reader:  bufio.NewReader(connection),
+		timeout: DefaultTimeout,
+	}
+}
+
+// NewScaleWithDial wraps connection in a Scale the same way NewScale does, but additionally redials the connection
+// using dial if a transaction fails with io.EOF or a closed-connection error, the same way ConnectSerial() and
+// ConnectTCP() do for their respective transports.
+//
+// Most callers should use ConnectSerial() or ConnectTCP() instead, which provide dial themselves. NewScaleWithDial
+// is for callers wrapping their own Transport implementation, and for tests exercising Scale's reconnect behavior
+// against a mtsicstest.MockScale.
+//
+// Inputs:
+//   - connection: the connection to the scale
+//   - dial: called to redial the connection when a transaction fails with a reconnectable error
+//
+// Outputs:
+//   - *Scale
+func NewScaleWithDial(connection io.ReadWriteCloser, dial func() (io.ReadWriteCloser, error)) *Scale {
+	s := NewScale(connection)
+	s.dial = dial
+	return s
+}
+
+// Close closes the underlying connection to the scale.
+func (s *Scale) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.conn.Close()
+}
+
+// isReconnectable reports whether err is the kind of error that a redial can recover from, e.g. the remote end of
+// the connection having gone away.
+func isReconnectable(err error) bool {
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrClosedPipe) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// reconnect closes the current connection and redials it, replacing s.conn and s.reader. The caller must hold s.mu.
+//
+// Any pending goroutine left over from an abandoned readFrame call belongs to the connection being replaced, so it
+// is discarded here rather than joined by a future readFrame call against the new reader.
+func (s *Scale) reconnect() error {
+	if s.dial == nil {
+		return fmt.Errorf("scale: connection lost and not reconnectable, Scale was not created with ConnectSerial() or ConnectTCP()")
+	}
+
+	_ = s.conn.Close()
+
+	connection, err := s.dial()
+	if err != nil {
+		return fmt.Errorf("scale: reconnect failed: %w", err)
+	}
+
+	s.conn = connection
+	s.reader = bufio.NewReader(connection)
+	s.pending = nil
+	return nil
+}
+
+// readFrame reads a single \r\n-terminated MT-SICS frame from the connection, or returns ctx.Err() if ctx is done
+// first. The caller must hold s.mu.
+//
+// The blocked read always races against ctx.Done() in a select, since a Transport's SetDeadline is not on its own
+// sufficient to interrupt it: SerialTransport.SetDeadline is a no-op (github.com/jacobsa/go-serial exposes no
+// deadlines), and even TCPTransport only has its deadline set once, from ctx.Deadline() at the start of the read, so
+// a ctx with no deadline (e.g. context.WithCancel) would otherwise not unblock a later cancellation until data
+// happens to arrive. When the connection is a Transport, this still sets its deadline from ctx.Deadline() as an
+// optimization, and calls SetDeadline(time.Now()) on ctx.Done() to proactively interrupt the underlying read.
+//
+// Because s.reader is a single shared bufio.Reader reused by the next transaction, a reader.ReadString call that is
+// still blocked when ctx is done cannot simply be abandoned: if the next readFrame call started a second goroutine
+// calling reader.ReadString concurrently, the two would race on the same bufio.Reader. Instead, readFrame stashes
+// the abandoned goroutine's result channel in s.pending, and the next call joins that same channel instead of
+// starting a new goroutine, so at most one goroutine ever reads from a given s.reader at a time.
+func (s *Scale) readFrame(ctx context.Context) ([]byte, error) {
+	transport, isTransport := s.conn.(Transport)
+	if isTransport {
+		if deadline, ok := ctx.Deadline(); ok {
+			if err := transport.SetDeadline(deadline); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	done := s.pending
+	if done == nil {
+		done = make(chan readResult, 1)
+		reader := s.reader
+		go func() {
+			line, err := reader.ReadString('\n')
+			done <- readResult{[]byte(strings.TrimRight(line, "\r\n")), err}
+		}()
+	}
+
+	select {
+	case <-ctx.Done():
+		if isTransport {
+			_ = transport.SetDeadline(time.Now())
+		}
+		s.pending = done
+		return nil, ctx.Err()
+	case r := <-done:
+		s.pending = nil
+		return r.line, r.err
+	}
+}
+
+// directCommand sends a command to the scale and waits for a frame matching regex, serializing concurrent callers
+// and transparently redialing the connection once if it was created with ConnectSerial() or ConnectTCP() and the
+// transaction fails with io.EOF.
+func (s *Scale) directCommand(ctx context.Context, command string, regex *regexp.Regexp) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err := s.conn.Write([]byte(command + "\r\n"))
+	if err != nil && isReconnectable(err) {
+		if rerr := s.reconnect(); rerr == nil {
+			_, err = s.conn.Write([]byte(command + "\r\n"))
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		frame, err := s.readFrame(ctx)
+		if err != nil {
+			if isReconnectable(err) {
+				if rerr := s.reconnect(); rerr == nil {
+					return s.directCommandLocked(ctx, command, regex)
+				}
+			}
+			return nil, err
+		}
+
+		if regex.Match(frame) {
+			return frame, nil
+		}
+	}
+}
+
+// directCommandLocked resends command and waits for a matching frame. The caller must already hold s.mu, which
+// lets directCommand retry a transaction right after a successful reconnect without releasing the lock to another
+// waiting goroutine first.
+func (s *Scale) directCommandLocked(ctx context.Context, command string, regex *regexp.Regexp) ([]byte, error) {
+	_, err := s.conn.Write([]byte(command + "\r\n"))
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		frame, err := s.readFrame(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if regex.Match(frame) {
+			return frame, nil
+		}
+	}
+}
+
+// defaultContext returns a context bound to the Scale's configured timeout, for the non-context methods below.
+func (s *Scale) defaultContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), s.timeout)
+}
+
+// DirectCommand sends a command to the scale and waits for the response of the MT-balance, serializing concurrent
+// callers. See the package-level DirectCommand() for details.
+func (s *Scale) DirectCommand(command string, regex *regexp.Regexp) ([]byte, error) {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.DirectCommandContext(ctx, command, regex)
+}
+
+// DirectCommandContext sends a command to the scale and waits for a matching response, the same way DirectCommand
+// does, but takes a context.Context to control cancellation and timeout.
+func (s *Scale) DirectCommandContext(ctx context.Context, command string, regex *regexp.Regexp) ([]byte, error) {
+	return s.directCommand(ctx, command, regex)
+}
+
+// SerialNumber retrieves the serial number of the scale (MT-SICS "I4"), and can be used as a connection health
+// check/ping.
+//
+// Outputs:
+//   - string: the serial number of the scale
+//   - error: see DirectCommand()
+func (s *Scale) SerialNumber() (string, error) {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.SerialNumberContext(ctx)
+}
+
+// SerialNumberContext retrieves the serial number of the scale, the same way SerialNumber does, but takes a
+// context.Context to control cancellation and timeout.
+func (s *Scale) SerialNumberContext(ctx context.Context) (string, error) {
+	regex := regexp.MustCompile(`I4 A "([^"]*)"`)
+	buf, err := s.directCommand(ctx, "I4", regex)
+	if err != nil {
+		return "", err
+	}
+
+	result := regex.FindStringSubmatch(string(buf))
+	return result[1], nil
+}
+
+// Ping checks that the scale is reachable and responding, by requesting its serial number.
+//
+// Outputs:
+//   - error: non-nil if the scale did not respond within the Scale's default timeout
+func (s *Scale) Ping() error {
+	_, err := s.SerialNumber()
+	return err
+}
+
+// SetTarget sets a target weight and tolerance on the scale. See the package-level SetTarget() for parameter docs.
+func (s *Scale) SetTarget(target float64, unit string, upperTolerance float64, lowerTolerance float64, relativeTolerance bool) error {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.SetTargetContext(ctx, target, unit, upperTolerance, lowerTolerance, relativeTolerance)
+}
+
+// SetTargetContext sets a target weight and tolerance on the scale, the same way SetTarget does, but takes a
+// context.Context to control cancellation and timeout.
+func (s *Scale) SetTargetContext(ctx context.Context, target float64, unit string, upperTolerance float64, lowerTolerance float64, relativeTolerance bool) error {
+	regex := regexp.MustCompile(`A10 A`)
+
+	targetString := "A10 0 " + strconv.FormatFloat(target, 'f', 2, 64) + " " + unit + ""
+	_, err := s.directCommand(ctx, targetString, regex)
+	if err != nil {
+		return err
+	}
+
+	if relativeTolerance {
+		unit = "%"
+	}
+
+	upperToleranceString := "A10 1 " + strconv.FormatFloat(upperTolerance, 'f', 2, 64) + " " + unit + ""
+	_, err = s.directCommand(ctx, upperToleranceString, regex)
+	if err != nil {
+		return err
+	}
+
+	lowerToleranceString := "A10 2 " + strconv.FormatFloat(lowerTolerance, 'f', 2, 64) + " " + unit + ""
+	_, err = s.directCommand(ctx, lowerToleranceString, regex)
+	return err
+}
+
+// SetResultID sets the result ID on the scale. See the package-level SetResultID() for parameter docs.
+func (s *Scale) SetResultID(label string, value string) error {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.SetResultIDContext(ctx, label, value)
+}
+
+// SetResultIDContext sets the result ID on the scale, the same way SetResultID does, but takes a context.Context to
+// control cancellation and timeout.
+func (s *Scale) SetResultIDContext(ctx context.Context, label string, value string) error {
+	msgString := "A36 1 \"" + label + "\" \"" + value + "\""
+	regex := regexp.MustCompile(`A36 A`)
+
+	_, err := s.directCommand(ctx, msgString, regex)
+	return err
+}
+
+// SetTaskID sets the task ID on the scale. See the package-level SetTaskID() for parameter docs.
+func (s *Scale) SetTaskID(label string, value string) error {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.SetTaskIDContext(ctx, label, value)
+}
+
+// SetTaskIDContext sets the task ID on the scale, the same way SetTaskID does, but takes a context.Context to
+// control cancellation and timeout.
+func (s *Scale) SetTaskIDContext(ctx context.Context, label string, value string) error {
+	msgString := "A37 1 \"" + label + "\" \"" + value + "\""
+	regex := regexp.MustCompile(`A37 A`)
+
+	_, err := s.directCommand(ctx, msgString, regex)
+	return err
+}
+
+// SetMessage sets a message on the display of the scale. See the package-level SetMessage() for parameter docs.
+func (s *Scale) SetMessage(message string) error {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.SetMessageContext(ctx, message)
+}
+
+// SetMessageContext sets a message on the display of the scale, the same way SetMessage does, but takes a
+// context.Context to control cancellation and timeout.
+func (s *Scale) SetMessageContext(ctx context.Context, message string) error {
+	msgString := "D \"" + message + "\""
+	regex := regexp.MustCompile(`D A`)
+
+	_, err := s.directCommand(ctx, msgString, regex)
+	return err
+}
+
+// ShowWeight clears the message on the display of the scale, showing the weight value.
+func (s *Scale) ShowWeight() error {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.ShowWeightContext(ctx)
+}
+
+// ShowWeightContext clears the message on the display of the scale, the same way ShowWeight does, but takes a
+// context.Context to control cancellation and timeout.
+func (s *Scale) ShowWeightContext(ctx context.Context) error {
+	regex := regexp.MustCompile(`DW A`)
+	_, err := s.directCommand(ctx, "DW", regex)
+	return err
+}
+
+// GetUnit retrieves the unit currently used by the scale. See the package-level GetUnit() for parameter docs.
+func (s *Scale) GetUnit(channel int) (string, error) {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.GetUnitContext(ctx, channel)
+}
+
+// GetUnitContext retrieves the unit currently used by the scale, the same way GetUnit does, but takes a
+// context.Context to control cancellation and timeout.
+func (s *Scale) GetUnitContext(ctx context.Context, channel int) (string, error) {
+	regex := regexp.MustCompile(`M21 A [0-9] ([a-zA-Z]+)`)
+	buf, err := s.directCommand(ctx, "M21 "+strconv.Itoa(channel), regex)
+	if err != nil {
+		return "", err
+	}
+
+	result := regex.FindStringSubmatch(string(buf))
+	return result[1], nil
+}
+
+// SetUnit sets the unit used by the scale. See the package-level SetUnit() for parameter docs.
+func (s *Scale) SetUnit(unit string, channel int) error {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.SetUnitContext(ctx, unit, channel)
+}
+
+// SetUnitContext sets the unit used by the scale, the same way SetUnit does, but takes a context.Context to control
+// cancellation and timeout.
+func (s *Scale) SetUnitContext(ctx context.Context, unit string, channel int) error {
+	regex := regexp.MustCompile(`M21 A`)
+	_, err := s.directCommand(ctx, "M21 "+strconv.Itoa(channel)+" "+unit, regex)
+	return err
+}
+
+// PowerOn turns the scale on from stand-by mode.
+func (s *Scale) PowerOn() error {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.PowerOnContext(ctx)
+}
+
+// PowerOnContext turns the scale on from stand-by mode, the same way PowerOn does, but takes a context.Context to
+// control cancellation and timeout.
+func (s *Scale) PowerOnContext(ctx context.Context) error {
+	regex := regexp.MustCompile(`PWR [AL]`) // PWR L will be returned if the scale is already on
+	_, err := s.directCommand(ctx, "PWR 1", regex)
+	return err
+}
+
+// PowerOff turns the scale into stand-by mode.
+func (s *Scale) PowerOff() error {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.PowerOffContext(ctx)
+}
+
+// PowerOffContext turns the scale into stand-by mode, the same way PowerOff does, but takes a context.Context to
+// control cancellation and timeout.
+func (s *Scale) PowerOffContext(ctx context.Context) error {
+	regex := regexp.MustCompile(`PWR [AL]`) // PWR L will be returned if the scale is already off
+	_, err := s.directCommand(ctx, "PWR 0", regex)
+	return err
+}
+
+// Weight retrieves the weight from the scale.
+//
+// Outputs:
+//   - measurement: the weight and unit of the measurement
+//   - error: see DirectCommand()
+func (s *Scale) Weight() (Measurement, error) {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.WeightContext(ctx)
+}
+
+// WeightContext retrieves the weight from the scale, the same way Weight does, but takes a context.Context to
+// control cancellation and timeout.
+func (s *Scale) WeightContext(ctx context.Context) (Measurement, error) {
+	regex := regexp.MustCompile(`S S +(-?[0-9]+\.[0-9]+) ([a-zA-Z]+)`)
+	buf, err := s.directCommand(ctx, "S", regex)
+	if err != nil {
+		return Measurement{}, err
+	}
+
+	result := regex.FindStringSubmatch(string(buf))
+	weightValue, err := strconv.ParseFloat(result[1], 64)
+	if err != nil {
+		return Measurement{}, err
+	}
+
+	return Measurement{Weight: weightValue, Unit: result[2], Stable: true}, nil
+}
+
+// WeightOnKey retrieves the weight from the scale when the transfer-key is pressed. See the package-level
+// WeightOnKey() for parameter docs.
+func (s *Scale) WeightOnKey(numMeasurements int, timeout time.Duration) ([]Measurement, error) {
+	if timeout == 0 && numMeasurements == 0 {
+		return []Measurement{}, fmt.Errorf("timeout and numMeasurements cannot both be infinite (0)")
+	}
+	if timeout == 0 {
+		timeout = 1<<63 - 1 // MaxInt64 = 292 years
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return s.WeightOnKeyContext(ctx, numMeasurements)
+}
+
+// WeightOnKeyContext retrieves the weight from the scale when the transfer-key is pressed, the same way
+// WeightOnKey does, but takes a context.Context to control cancellation and timeout instead of the timeout
+// parameter.
+func (s *Scale) WeightOnKeyContext(ctx context.Context, numMeasurements int) ([]Measurement, error) {
+	if numMeasurements == 0 {
+		numMeasurements = math.MaxInt
+	}
+
+	initRegex := regexp.MustCompile(`ST A`)
+	if _, err := s.directCommand(ctx, "ST 1", initRegex); err != nil {
+		return []Measurement{}, err
+	}
+
+	defer func() {
+		stopCtx, cancel := s.defaultContext()
+		defer cancel()
+		stopRegex := regexp.MustCompile(`ST [AL]`) // ST L will be returned if the reading is already stopped by user interrupt
+		_, _ = s.directCommand(stopCtx, "ST 0", stopRegex)
+	}()
+
+	weightRegex := regexp.MustCompile(`ST +(-?[0-9]+\.[0-9]+) ([a-zA-Z]+)`)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var weightList []Measurement
+	for len(weightList) < numMeasurements {
+		frame, err := s.readFrame(ctx)
+		if err != nil {
+			return []Measurement{}, err
+		}
+
+		if !weightRegex.Match(frame) {
+			continue
+		}
+
+		result := weightRegex.FindStringSubmatch(string(frame))
+		weightValue, err := strconv.ParseFloat(result[1], 64)
+		if err != nil {
+			return []Measurement{}, err
+		}
+
+		weightList = append(weightList, Measurement{Weight: weightValue, Unit: result[2], Stable: true})
+	}
+
+	return weightList, nil
+}
+
+// Tare sets the current weight as the tare weight.
+//
+// Outputs:
+//   - Measurement: the weight and unit of the measurement
+//   - error: see DirectCommand()
+func (s *Scale) Tare() (Measurement, error) {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.TareContext(ctx)
+}
+
+// TareContext sets the current weight as the tare weight, the same way Tare does, but takes a context.Context to
+// control cancellation and timeout.
+func (s *Scale) TareContext(ctx context.Context) (Measurement, error) {
+	regex := regexp.MustCompile(`T S +(-?[0-9]+\.[0-9]+) ([a-zA-Z]+)`)
+	buf, err := s.directCommand(ctx, "T", regex)
+	if err != nil {
+		return Measurement{}, err
+	}
+	result := regex.FindStringSubmatch(string(buf))
+	weightValue, err := strconv.ParseFloat(result[1], 64)
+	if err != nil {
+		return Measurement{}, err
+	}
+	return Measurement{Weight: weightValue, Unit: result[2], Stable: true}, nil
+}
+
+// GetDoorStatus retrieves the status of the Draft shield doors. See the package-level GetDoorStatus() for the
+// status table.
+func (s *Scale) GetDoorStatus() (string, error) {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.GetDoorStatusContext(ctx)
+}
+
+// GetDoorStatusContext retrieves the status of the Draft shield doors, the same way GetDoorStatus does, but takes a
+// context.Context to control cancellation and timeout.
+func (s *Scale) GetDoorStatusContext(ctx context.Context) (string, error) {
+	regex := regexp.MustCompile(`WS ([0-9])`) // capture group is required: FindStringSubmatch below needs it
+
+	buf, err := s.directCommand(ctx, "WS ([0-9])", regex)
+	if err != nil {
+		return "", err
+	}
+
+	result := regex.FindStringSubmatch(string(buf))
+	return result[1], nil
+}
+
+// CloseAllDoors closes all draft shield doors.
+func (s *Scale) CloseAllDoors() error {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.CloseAllDoorsContext(ctx)
+}
+
+// CloseAllDoorsContext closes all draft shield doors, the same way CloseAllDoors does, but takes a context.Context
+// to control cancellation and timeout.
+func (s *Scale) CloseAllDoorsContext(ctx context.Context) error {
+	regex := regexp.MustCompile(`WS [AL]`) // WS L will be returned if the doors are already closed
+	_, err := s.directCommand(ctx, "WS 0", regex)
+	return err
+}
+
+// OpenRightDoor opens the right draft shield door.
+func (s *Scale) OpenRightDoor() error {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.OpenRightDoorContext(ctx)
+}
+
+// OpenRightDoorContext opens the right draft shield door, the same way OpenRightDoor does, but takes a
+// context.Context to control cancellation and timeout.
+func (s *Scale) OpenRightDoorContext(ctx context.Context) error {
+	regex := regexp.MustCompile(`WS [AL]`) // WS L will be returned if the right door is already open
+	_, err := s.directCommand(ctx, "WS 1", regex)
+	return err
+}
+
+// OpenLeftDoor opens the left draft shield door.
+func (s *Scale) OpenLeftDoor() error {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.OpenLeftDoorContext(ctx)
+}
+
+// OpenLeftDoorContext opens the left draft shield door, the same way OpenLeftDoor does, but takes a
+// context.Context to control cancellation and timeout.
+func (s *Scale) OpenLeftDoorContext(ctx context.Context) error {
+	regex := regexp.MustCompile(`WS [AL]`) // WS L will be returned if the left door is already open
+	_, err := s.directCommand(ctx, "WS 2", regex)
+	return err
+}
+
+// Zero sets the current weight as the zero weight.
+func (s *Scale) Zero() error {
+	ctx, cancel := s.defaultContext()
+	defer cancel()
+	return s.ZeroContext(ctx)
+}
+
+// ZeroContext sets the current weight as the zero weight, the same way Zero does, but takes a context.Context to
+// control cancellation and timeout.
+func (s *Scale) ZeroContext(ctx context.Context) error {
+	regex := regexp.MustCompile(`Z A`)
+	_, err := s.directCommand(ctx, "Z", regex)
+	return err
+}
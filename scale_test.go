@@ -0,0 +1,359 @@
+package MT_SICS_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	MT_SICS "github.com/bas-dehaan/MT-SICS"
+	"github.com/bas-dehaan/MT-SICS/mtsicstest"
+)
+
+func TestScale_Weight(t *testing.T) {
+	mock := mtsicstest.NewMockScale()
+	mock.SetWeight(mtsicstest.Measurement{Weight: 12.34, Unit: "g", Stable: true})
+	scale := MT_SICS.NewScale(mock)
+
+	got, err := scale.Weight()
+	if err != nil {
+		t.Fatalf("Weight() error = %v", err)
+	}
+
+	want := MT_SICS.Measurement{Weight: 12.34, Unit: "g", Stable: true}
+	if got != want {
+		t.Errorf("Weight() = %+v, want %+v", got, want)
+	}
+}
+
+func TestScale_Tare(t *testing.T) {
+	mock := mtsicstest.NewMockScale()
+	mock.SetWeight(mtsicstest.Measurement{Weight: 5, Unit: "g", Stable: true})
+	scale := MT_SICS.NewScale(mock)
+
+	got, err := scale.Tare()
+	if err != nil {
+		t.Fatalf("Tare() error = %v", err)
+	}
+
+	// The mock zeroes its weight in response to T, mirroring a real balance taring out its current load.
+	want := MT_SICS.Measurement{Weight: 0, Unit: "g", Stable: true}
+	if got != want {
+		t.Errorf("Tare() = %+v, want %+v", got, want)
+	}
+}
+
+func TestScale_Zero(t *testing.T) {
+	scale := MT_SICS.NewScale(mtsicstest.NewMockScale())
+
+	if err := scale.Zero(); err != nil {
+		t.Fatalf("Zero() error = %v", err)
+	}
+}
+
+func TestScale_SetTarget(t *testing.T) {
+	scale := MT_SICS.NewScale(mtsicstest.NewMockScale())
+
+	if err := scale.SetTarget(100, "g", 1, 1, false); err != nil {
+		t.Fatalf("SetTarget() error = %v", err)
+	}
+}
+
+func TestScale_SetResultIDAndTaskID(t *testing.T) {
+	scale := MT_SICS.NewScale(mtsicstest.NewMockScale())
+
+	if err := scale.SetResultID("Sample No.:", "1234"); err != nil {
+		t.Fatalf("SetResultID() error = %v", err)
+	}
+	if err := scale.SetTaskID("Process step:", "1st weighing"); err != nil {
+		t.Fatalf("SetTaskID() error = %v", err)
+	}
+}
+
+func TestScale_SetMessageAndShowWeight(t *testing.T) {
+	scale := MT_SICS.NewScale(mtsicstest.NewMockScale())
+
+	if err := scale.SetMessage("See PC for instructions"); err != nil {
+		t.Fatalf("SetMessage() error = %v", err)
+	}
+	if err := scale.ShowWeight(); err != nil {
+		t.Fatalf("ShowWeight() error = %v", err)
+	}
+}
+
+func TestScale_GetAndSetUnit(t *testing.T) {
+	scale := MT_SICS.NewScale(mtsicstest.NewMockScale())
+
+	if err := scale.SetUnit("kg", 0); err != nil {
+		t.Fatalf("SetUnit() error = %v", err)
+	}
+
+	got, err := scale.GetUnit(0)
+	if err != nil {
+		t.Fatalf("GetUnit() error = %v", err)
+	}
+	if got != "kg" {
+		t.Errorf("GetUnit() = %q, want %q", got, "kg")
+	}
+}
+
+func TestScale_PowerOnOff(t *testing.T) {
+	scale := MT_SICS.NewScale(mtsicstest.NewMockScale())
+
+	// The mock starts powered on. PWR's "already in this state" reply (PWR L) still matches the success regex, so
+	// PowerOn() succeeds here exactly like it would against real hardware.
+	if err := scale.PowerOn(); err != nil {
+		t.Fatalf("PowerOn() error = %v", err)
+	}
+
+	if err := scale.PowerOff(); err != nil {
+		t.Fatalf("PowerOff() error = %v", err)
+	}
+	if err := scale.PowerOn(); err != nil {
+		t.Fatalf("PowerOn() error = %v", err)
+	}
+}
+
+func TestScale_Doors(t *testing.T) {
+	scale := MT_SICS.NewScale(mtsicstest.NewMockScale())
+
+	status, err := scale.GetDoorStatus()
+	if err != nil {
+		t.Fatalf("GetDoorStatus() error = %v", err)
+	}
+	if status != "0" {
+		t.Errorf("GetDoorStatus() = %q, want %q", status, "0")
+	}
+
+	if err := scale.OpenRightDoor(); err != nil {
+		t.Fatalf("OpenRightDoor() error = %v", err)
+	}
+
+	status, err = scale.GetDoorStatus()
+	if err != nil {
+		t.Fatalf("GetDoorStatus() error = %v", err)
+	}
+	if status != "1" {
+		t.Errorf("GetDoorStatus() after OpenRightDoor() = %q, want %q", status, "1")
+	}
+
+	if err := scale.CloseAllDoors(); err != nil {
+		t.Fatalf("CloseAllDoors() error = %v", err)
+	}
+}
+
+func TestScale_SerialNumberAndPing(t *testing.T) {
+	mock := mtsicstest.NewMockScale()
+	mock.SetSerialNumber("ABC123")
+	scale := MT_SICS.NewScale(mock)
+
+	got, err := scale.SerialNumber()
+	if err != nil {
+		t.Fatalf("SerialNumber() error = %v", err)
+	}
+	if got != "ABC123" {
+		t.Errorf("SerialNumber() = %q, want %q", got, "ABC123")
+	}
+
+	if err := scale.Ping(); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+}
+
+func TestScale_ReconnectsOnEOF(t *testing.T) {
+	first := mtsicstest.NewMockScale()
+	first.SetSerialNumber("ABC123")
+	first.FailNextRead(io.EOF)
+
+	second := mtsicstest.NewMockScale()
+	second.SetSerialNumber("ABC123")
+
+	dialed := false
+	scale := MT_SICS.NewScaleWithDial(first, func() (io.ReadWriteCloser, error) {
+		dialed = true
+		return second, nil
+	})
+
+	got, err := scale.SerialNumber()
+	if err != nil {
+		t.Fatalf("SerialNumber() error = %v", err)
+	}
+	if got != "ABC123" {
+		t.Errorf("SerialNumber() = %q, want %q", got, "ABC123")
+	}
+	if !dialed {
+		t.Error("SerialNumber() did not redial after the first connection returned io.EOF")
+	}
+}
+
+func TestScale_ReconnectFailsWithoutDial(t *testing.T) {
+	mock := mtsicstest.NewMockScale()
+	mock.FailNextRead(io.EOF)
+	scale := MT_SICS.NewScale(mock)
+
+	if _, err := scale.SerialNumber(); err == nil {
+		t.Fatal("SerialNumber() error = nil, want an error since the Scale was not created with a dial func")
+	}
+}
+
+func TestScale_WeightOnKey(t *testing.T) {
+	mock := mtsicstest.NewMockScale()
+	scale := MT_SICS.NewScale(mock)
+
+	go func() {
+		mock.PressKey(mtsicstest.Measurement{Weight: 1.5, Unit: "g", Stable: true})
+		mock.PressKey(mtsicstest.Measurement{Weight: 2.5, Unit: "g", Stable: true})
+	}()
+
+	got, err := scale.WeightOnKey(2, 2*time.Second)
+	if err != nil {
+		t.Fatalf("WeightOnKey() error = %v", err)
+	}
+
+	want := []MT_SICS.Measurement{
+		{Weight: 1.5, Unit: "g", Stable: true},
+		{Weight: 2.5, Unit: "g", Stable: true},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("WeightOnKey() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("WeightOnKey()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestScale_StreamWeight(t *testing.T) {
+	mock := mtsicstest.NewMockScale()
+	mock.SimulateConverging(
+		[]mtsicstest.Measurement{
+			{Weight: 9.7, Unit: "g", Stable: false},
+			{Weight: 9.9, Unit: "g", Stable: false},
+		},
+		mtsicstest.Measurement{Weight: 10.0, Unit: "g", Stable: true},
+	)
+	scale := MT_SICS.NewScale(mock)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	measurements, errs, err := scale.StreamWeight(ctx, MT_SICS.StreamImmediateRepeat)
+	if err != nil {
+		t.Fatalf("StreamWeight() error = %v", err)
+	}
+
+	want := []MT_SICS.Measurement{
+		{Weight: 9.7, Unit: "g", Stable: false},
+		{Weight: 9.9, Unit: "g", Stable: false},
+		{Weight: 10.0, Unit: "g", Stable: true},
+	}
+
+	for i, w := range want {
+		select {
+		case got, ok := <-measurements:
+			if !ok {
+				t.Fatalf("measurements closed early at index %d", i)
+			}
+			if got != w {
+				t.Errorf("measurement[%d] = %+v, want %+v", i, got, w)
+			}
+		case err := <-errs:
+			t.Fatalf("unexpected stream error: %v", err)
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for measurement %d", i)
+		}
+	}
+}
+
+// TestScale_ConcurrentCallsAreSerialized exercises Scale's mutex directly: many goroutines share one Scale and
+// MockScale, each repeatedly issuing a distinct command whose response is predictable. Without the mutex
+// serializing request/response transactions, concurrent Write/ReadString calls on the shared connection and
+// bufio.Reader would interleave, so a goroutine could read a frame meant for another command, fail to match its
+// regex, and return a wrong result or hang; run with `go test -race` to also catch the underlying data race.
+func TestScale_ConcurrentCallsAreSerialized(t *testing.T) {
+	mock := mtsicstest.NewMockScale()
+	mock.SetSerialNumber("ABC123")
+	mock.SetLatency(time.Millisecond)
+	scale := MT_SICS.NewScale(mock)
+
+	const goroutines = 8
+	const iterations = 20
+
+	var wg sync.WaitGroup
+	errs := make(chan error, goroutines*iterations)
+
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for j := 0; j < iterations; j++ {
+				switch worker % 4 {
+				case 0:
+					if got, err := scale.SerialNumber(); err != nil {
+						errs <- err
+					} else if got != "ABC123" {
+						errs <- fmt.Errorf("SerialNumber() = %q, want %q", got, "ABC123")
+					}
+				case 1:
+					if _, err := scale.Weight(); err != nil {
+						errs <- err
+					}
+				case 2:
+					if got, err := scale.GetDoorStatus(); err != nil {
+						errs <- err
+					} else if got != "0" {
+						errs <- fmt.Errorf("GetDoorStatus() = %q, want %q", got, "0")
+					}
+				case 3:
+					if err := scale.SetMessage("hi"); err != nil {
+						errs <- err
+					}
+				}
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestScale_DirectCommandTimesOutOnLatency(t *testing.T) {
+	mock := mtsicstest.NewMockScale()
+	mock.SetLatency(200 * time.Millisecond)
+	scale := MT_SICS.NewScale(mock)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := scale.WeightContext(ctx); err == nil {
+		t.Fatal("WeightContext() error = nil, want a context deadline error")
+	}
+}
+
+// TestScale_ConsecutiveTimeoutsDoNotRaceReader guards against readFrame starting a second reader goroutine while
+// the previous call's ReadString is still blocked on the shared bufio.Reader: mock's 200ms latency guarantees the
+// first attempt's read is still in flight when the second attempt begins reading right after the first is canceled.
+// Each ctx is canceled by a timer rather than given a deadline, since readFrame would pass a deadline on to mock via
+// SetDeadline and end its Read early. Run with `go test -race` to catch a regression.
+func TestScale_ConsecutiveTimeoutsDoNotRaceReader(t *testing.T) {
+	mock := mtsicstest.NewMockScale()
+	mock.SetLatency(200 * time.Millisecond)
+	scale := MT_SICS.NewScale(mock)
+
+	for i := 0; i < 2; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(20*time.Millisecond, cancel)
+		if _, err := scale.WeightContext(ctx); err == nil {
+			cancel()
+			t.Fatalf("WeightContext() attempt %d error = nil, want a context deadline error", i)
+		}
+		cancel()
+	}
+}
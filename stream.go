@@ -0,0 +1,112 @@
+package MT_SICS
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+)
+
+// StreamMode selects which MT-SICS continuous weight transmission command Scale.StreamWeight starts.
+type StreamMode struct {
+	command string
+}
+
+// StreamImmediate makes the scale send the current weight value once, the same way the MT-SICS "SI" command does.
+// The channels returned by Scale.StreamWeight still stay open until ctx is done.
+var StreamImmediate = StreamMode{command: "SI"}
+
+// StreamImmediateRepeat starts a continuous stream that sends a weight value immediately, and again every time the
+// value changes, the same way the MT-SICS "SIR" command does.
+var StreamImmediateRepeat = StreamMode{command: "SIR"}
+
+// StreamOnStableChange starts a continuous stream that sends a weight value every time the scale settles on a new
+// stable reading, the same way the MT-SICS "SR" command does.
+//
+// Inputs:
+//   - minimumChange: the minimum change in weight, in the host unit, that triggers a new reading. 0 uses the
+//     scale's own default threshold.
+func StreamOnStableChange(minimumChange float64) StreamMode {
+	if minimumChange <= 0 {
+		return StreamMode{command: "SR"}
+	}
+
+	return StreamMode{command: "SR " + strconv.FormatFloat(minimumChange, 'f', -1, 64)}
+}
+
+// streamFrameRegex matches a continuous transmission frame, e.g. "S S 12.34 g" (stable) or "S D 12.34 g" (dynamic).
+var streamFrameRegex = regexp.MustCompile(`S ([SD]) +(-?[0-9]+\.[0-9]+) ([a-zA-Z]+)`)
+
+// StreamWeight subscribes to a live stream of weight measurements from the scale using the MT-SICS continuous
+// transmission commands (SI, SIR, SR; see StreamMode). A single reader goroutine parses every "S S|D <value> <unit>"
+// frame the scale sends into a Measurement, setting Measurement.Stable from the S/D marker, and pushes it onto the
+// returned channel.
+//
+// The stream, and the goroutine, run until ctx is done, at which point the MT-SICS cancel command ("@") is sent to
+// stop the scale from transmitting further and both channels are closed. Because StreamWeight holds the Scale's
+// transaction lock for the lifetime of the stream, no other command can be issued on the same Scale until ctx is
+// done. Cancellation is the only way to stop the stream: the reader goroutine blocks on sending to the measurements
+// channel, so a caller that stops draining it without canceling ctx wedges the goroutine, and the Scale, forever.
+// Callers must either drain measurements until it closes, or cancel ctx as soon as they stop reading.
+//
+// Inputs:
+//   - ctx: controls the lifetime of the stream; canceling ctx stops the stream and closes both returned channels
+//   - mode: selects the continuous transmission command to use, see StreamImmediate, StreamImmediateRepeat and
+//     StreamOnStableChange
+//
+// Outputs:
+//   - <-chan Measurement: receives a Measurement for every frame the scale sends; closed when the stream ends
+//   - <-chan error: receives an error if the stream ended abnormally, then is closed; never receives ctx.Err()
+//   - error: an error starting the stream, e.g. writing the start command failed
+func (s *Scale) StreamWeight(ctx context.Context, mode StreamMode) (<-chan Measurement, <-chan error, error) {
+	s.mu.Lock()
+
+	if _, err := s.conn.Write([]byte(mode.command + "\r\n")); err != nil {
+		s.mu.Unlock()
+		return nil, nil, err
+	}
+
+	measurements := make(chan Measurement)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer s.mu.Unlock()
+		defer close(measurements)
+		defer close(errs)
+		defer func() {
+			// Cancel continuous transmission; the scale's acknowledgement, if any, is left for the next caller's
+			// transaction to discard, since the stream's reader has already stopped consuming frames.
+			_, _ = s.conn.Write([]byte("@\r\n"))
+		}()
+
+		for {
+			frame, err := s.readFrame(ctx)
+			if err != nil {
+				if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+					errs <- err
+				}
+				return
+			}
+
+			result := streamFrameRegex.FindStringSubmatch(string(frame))
+			if result == nil {
+				continue
+			}
+
+			weightValue, err := strconv.ParseFloat(result[2], 64)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			measurement := Measurement{Weight: weightValue, Unit: result[3], Stable: result[1] == "S"}
+			select {
+			case measurements <- measurement:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return measurements, errs, nil
+}
@@ -0,0 +1,209 @@
+package MT_SICS
+
+import (
+	"crypto/tls"
+	"github.com/jacobsa/go-serial/serial"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+// Transport is the connection a Scale sends MT-SICS commands over. In addition to io.ReadWriteCloser, it exposes
+// Flush() to discard any bytes buffered by the underlying link and SetDeadline() so that Scale can, on a best-effort
+// basis, proactively interrupt a blocked read when ctx is done rather than relying solely on the goroutine started
+// by DirectCommandContext's context-cancellation fallback.
+//
+// SerialTransport and TCPTransport are the two implementations provided by this package.
+type Transport interface {
+	io.ReadWriteCloser
+
+	// Flush discards any bytes buffered by the underlying link that have not yet been read.
+	Flush() error
+
+	// SetDeadline sets the deadline for both Read and Write calls, the same way net.Conn.SetDeadline does. A zero
+	// time.Time disables the deadline.
+	SetDeadline(deadline time.Time) error
+}
+
+// SerialConfig configures a SerialTransport, see OpenSerial() and ConnectSerial().
+type SerialConfig struct {
+	// Port is the serial port to connect to, e.g. COM1 or /dev/ttyUSB0.
+	Port string
+	// BaudRate is the baud rate to connect with. Defaults to 9600 if 0.
+	BaudRate uint
+	// DataBits is the number of data bits per frame. Legal values are 5, 6, 7 and 8. Defaults to 8 if 0.
+	DataBits uint
+	// StopBits is the number of stop bits per frame. Legal values are 1 and 2. Defaults to 1 if 0.
+	StopBits uint
+	// Parity is the parity mode to use for the connection. Defaults to serial.PARITY_NONE.
+	Parity serial.ParityMode
+	// Timeout is the default timeout used by the non-context methods on the Scale returned by ConnectSerial().
+	// Defaults to DefaultTimeout if 0.
+	Timeout time.Duration
+}
+
+// SerialTransport adapts the io.ReadWriteCloser returned by github.com/jacobsa/go-serial to the Transport interface.
+type SerialTransport struct {
+	io.ReadWriteCloser
+}
+
+// Flush is a no-op: github.com/jacobsa/go-serial does not buffer reads or writes beyond the OS driver.
+func (t *SerialTransport) Flush() error {
+	return nil
+}
+
+// SetDeadline is a no-op: github.com/jacobsa/go-serial does not expose read/write deadlines on the connections it
+// returns. Callers relying on cancellation should prefer TCPTransport, or accept that a blocked Read on a serial
+// port cannot be interrupted until data arrives or the port is closed.
+func (t *SerialTransport) SetDeadline(time.Time) error {
+	return nil
+}
+
+// OpenSerial dials the serial port described by cfg and returns it as a Transport.
+//
+// Inputs:
+//   - cfg: the serial port, baud rate, framing and parity to use
+//
+// Outputs:
+//   - Transport
+//   - error: an error dialing the port
+func OpenSerial(cfg SerialConfig) (Transport, error) {
+	baudRate := cfg.BaudRate
+	if baudRate == 0 {
+		baudRate = 9600
+	}
+
+	dataBits := cfg.DataBits
+	if dataBits == 0 {
+		dataBits = 8
+	}
+
+	stopBits := cfg.StopBits
+	if stopBits == 0 {
+		stopBits = 1
+	}
+
+	connection, err := serial.Open(serial.OpenOptions{
+		PortName:        cfg.Port,
+		BaudRate:        baudRate,
+		DataBits:        dataBits,
+		StopBits:        stopBits,
+		ParityMode:      cfg.Parity,
+		MinimumReadSize: 4,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SerialTransport{ReadWriteCloser: connection}, nil
+}
+
+// ConnectSerial dials the serial port described by cfg and wraps it in a Scale. Like ConnectTCP, the resulting
+// Scale automatically redials the port if a transaction fails with io.EOF or a closed-connection error.
+//
+// Inputs:
+//   - cfg: the serial port, baud rate, framing and default timeout to use
+//
+// Outputs:
+//   - *Scale
+//   - error: an error dialing the port
+func ConnectSerial(cfg SerialConfig) (*Scale, error) {
+	transport, err := OpenSerial(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	s := NewScaleWithDial(transport, func() (io.ReadWriteCloser, error) { return OpenSerial(cfg) })
+	if cfg.Timeout > 0 {
+		s.timeout = cfg.Timeout
+	}
+
+	return s, nil
+}
+
+// TCPConfig configures a TCPTransport, see OpenTCP() and ConnectTCP(). Many current MT balances (XPR, XSR, MS-TS)
+// expose MT-SICS over TCP on port 8001 instead of, or in addition to, RS-232.
+type TCPConfig struct {
+	// Host is the hostname or IP address of the scale.
+	Host string
+	// Port is the TCP port to dial. Defaults to 8001, the standard MT-SICS-over-Ethernet port, if 0.
+	Port int
+	// TLS enables TLS on the connection when non-nil.
+	TLS *tls.Config
+	// KeepAlive is the TCP keep-alive period. 0 uses the operating system default; negative disables keep-alive.
+	KeepAlive time.Duration
+	// Timeout is the default timeout used by the non-context methods on the Scale returned by ConnectTCP(), and the
+	// dial timeout for the TCP connection itself. Defaults to DefaultTimeout if 0.
+	Timeout time.Duration
+}
+
+// TCPTransport adapts a net.Conn to the Transport interface.
+type TCPTransport struct {
+	net.Conn
+}
+
+// Flush is a no-op: TCP writes are not buffered by this package, and there is nothing meaningful to discard on the
+// read side without racing incoming data.
+func (t *TCPTransport) Flush() error {
+	return nil
+}
+
+// OpenTCP dials the scale described by cfg over TCP and returns it as a Transport.
+//
+// Inputs:
+//   - cfg: the host, port and optional TLS config to dial
+//
+// Outputs:
+//   - Transport
+//   - error: an error dialing the host
+func OpenTCP(cfg TCPConfig) (Transport, error) {
+	port := cfg.Port
+	if port == 0 {
+		port = 8001
+	}
+
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+
+	address := net.JoinHostPort(cfg.Host, strconv.Itoa(port))
+	dialer := &net.Dialer{Timeout: timeout, KeepAlive: cfg.KeepAlive}
+
+	var connection net.Conn
+	var err error
+	if cfg.TLS != nil {
+		connection, err = tls.DialWithDialer(dialer, "tcp", address, cfg.TLS)
+	} else {
+		connection, err = dialer.Dial("tcp", address)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &TCPTransport{Conn: connection}, nil
+}
+
+// ConnectTCP dials the scale described by cfg over TCP and wraps it in a Scale. Like ConnectSerial, the resulting
+// Scale automatically redials the connection if a transaction fails with io.EOF or a closed-connection error.
+//
+// Inputs:
+//   - cfg: the host, port, TLS and default timeout to use
+//
+// Outputs:
+//   - *Scale
+//   - error: an error dialing the host
+func ConnectTCP(cfg TCPConfig) (*Scale, error) {
+	transport, err := OpenTCP(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	s := NewScaleWithDial(transport, func() (io.ReadWriteCloser, error) { return OpenTCP(cfg) })
+	if cfg.Timeout > 0 {
+		s.timeout = cfg.Timeout
+	}
+
+	return s, nil
+}